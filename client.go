@@ -0,0 +1,214 @@
+package govultr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultBaseURL = "https://api.vultr.com"
+	libraryVersion = "0.1.0"
+	mediaType      = "application/json"
+
+	// defaultRateLimitSleep is applied after every request to stay under Vultr's
+	// per-second request cap.
+	defaultRateLimitSleep = 700 * time.Millisecond
+)
+
+// Client manages communication with the Vultr API.
+type Client struct {
+	client *http.Client
+
+	BaseURL   *url.URL
+	UserAgent string
+	APIKey    string
+
+	// RateLimitSleep is slept after every request completes, successful or not. It is superseded
+	// by a limiter installed with WithRateLimit.
+	RateLimitSleep time.Duration
+
+	limiter     *tokenBucket
+	retryPolicy *retryPolicy
+	onRetry     func(attempt int, err error)
+
+	Network NetworkService
+}
+
+// ClientOption configures optional behavior on a Client, such as rate limiting and retries.
+type ClientOption func(*Client)
+
+// WithRateLimit caps outgoing requests to rps requests per second, allowing bursts of up to
+// burst requests before further calls are throttled. It replaces the coarser fixed
+// RateLimitSleep with a token-bucket limiter shared by every service on the client.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.limiter = newTokenBucket(rps, burst)
+	}
+}
+
+// WithRetry makes up to maxAttempts attempts in total (the first one included) at a request that
+// fails outright or comes back 429/5xx, backing off exponentially from baseDelay with jitter between attempts and honoring
+// any Retry-After header the API sends.
+func WithRetry(maxAttempts int, baseDelay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = &retryPolicy{maxAttempts: maxAttempts, baseDelay: baseDelay}
+	}
+}
+
+// WithRetryObserver registers fn to be called before each retry attempt, e.g. to record metrics.
+func WithRetryObserver(fn func(attempt int, err error)) ClientOption {
+	return func(c *Client) {
+		c.onRetry = fn
+	}
+}
+
+// NewClient returns a new Vultr API client. If httpClient is nil, http.DefaultClient is used.
+func NewClient(httpClient *http.Client, apiKey string, opts ...ClientOption) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	c := &Client{
+		client:         httpClient,
+		BaseURL:        baseURL,
+		UserAgent:      "govultr/" + libraryVersion,
+		APIKey:         apiKey,
+		RateLimitSleep: defaultRateLimitSleep,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.Network = &NetworkServiceHandler{client: c}
+
+	return c
+}
+
+// NewRequest creates an API request. uri is resolved relative to the client's BaseURL. For GET
+// requests body is encoded as a query string; for all other methods it is sent as the form body.
+func (c *Client) NewRequest(ctx context.Context, method, uri string, body url.Values) (*http.Request, error) {
+	resolvedURL, err := c.BaseURL.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	if method == http.MethodGet {
+		if body != nil {
+			resolvedURL.RawQuery = body.Encode()
+		}
+		req, err = http.NewRequest(method, resolvedURL.String(), nil)
+	} else {
+		req, err = http.NewRequest(method, resolvedURL.String(), bytes.NewBufferString(body.Encode()))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("API-Key", c.APIKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", mediaType)
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	return req, nil
+}
+
+// DoWithContext sends an API request and, if data is non-nil, decodes the JSON response body
+// into it. The raw *http.Response of the latest attempt is returned even on error so callers can
+// inspect rate-limit headers, request IDs, and status codes; it is nil when no response was received.
+//
+// If a rate limiter was installed with WithRateLimit, it is applied before every attempt. If a
+// retry policy was installed with WithRetry, a request that fails outright or comes back 429/5xx
+// is retried with backoff until it succeeds or the attempt budget is exhausted.
+func (c *Client) DoWithContext(ctx context.Context, req *http.Request, data interface{}) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, gerr := req.GetBody()
+			if gerr != nil {
+				return resp, gerr
+			}
+			req.Body = body
+		}
+
+		if c.limiter != nil {
+			if werr := c.limiter.wait(ctx); werr != nil {
+				return resp, werr
+			}
+		}
+
+		resp, err = c.do(ctx, req, data)
+
+		if c.retryPolicy == nil || !c.retryPolicy.shouldRetry(resp, err) {
+			return resp, err
+		}
+		if attempt+1 >= c.retryPolicy.maxAttempts {
+			return resp, err
+		}
+
+		if c.onRetry != nil {
+			c.onRetry(attempt+1, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		case <-time.After(c.retryPolicy.delay(attempt, resp)):
+		}
+	}
+}
+
+// do performs a single attempt at sending req and decoding its response into data.
+func (c *Client) do(ctx context.Context, req *http.Request, data interface{}) (*http.Response, error) {
+	req = req.WithContext(ctx)
+
+	resp, err := c.client.Do(req)
+
+	// A configured limiter supersedes the fixed sleep.
+	if c.limiter == nil {
+		defer time.Sleep(c.RateLimitSleep)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+
+	if err := CheckResponse(resp, respBody); err != nil {
+		return resp, err
+	}
+
+	if data != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, data); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// CheckResponse checks an API response for a non-2xx status code and returns an error describing
+// it, if present.
+func CheckResponse(resp *http.Response, body []byte) error {
+	if c := resp.StatusCode; c >= 200 && c <= 299 {
+		return nil
+	}
+
+	return fmt.Errorf("vultr: %d %s", resp.StatusCode, string(body))
+}
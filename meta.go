@@ -0,0 +1,15 @@
+package govultr
+
+// Meta describes pagination metadata for a single page of a list response. Total is the number
+// of items across all pages after any filtering has been applied, not just the items returned.
+type Meta struct {
+	Total int    `json:"total"`
+	Links *Links `json:"links"`
+}
+
+// Links holds cursors for paging forwards and backwards through a list response. A nil/empty
+// cursor means there is no further page in that direction.
+type Links struct {
+	Next string `json:"next"`
+	Prev string `json:"prev"`
+}
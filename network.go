@@ -5,15 +5,20 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 )
 
 // NetworkService is the interface to interact with the network endpoints on the Vultr API
 // Link: https://www.vultr.com/api/#network
 type NetworkService interface {
-	Create(ctx context.Context, regionID, description, cidrBlock string) (*Network, error)
-	Destroy(ctx context.Context, networkID string) error
-	GetList(ctx context.Context) ([]Network, error)
+	Create(ctx context.Context, regionID, description, cidrBlock string) (*Network, *http.Response, error)
+	Destroy(ctx context.Context, networkID string) (*http.Response, error)
+	GetList(ctx context.Context, options *ListOptions) ([]Network, *Meta, *http.Response, error)
+	AttachInstance(ctx context.Context, networkID, instanceID string) (*http.Response, error)
+	DetachInstance(ctx context.Context, networkID, instanceID string) (*http.Response, error)
+	ListInstances(ctx context.Context, networkID string) ([]string, *http.Response, error)
+	Update(ctx context.Context, networkID, description string) (*http.Response, error)
 }
 
 // NetworkServiceHandler handles interaction with the network methods for the Vultr API
@@ -28,11 +33,15 @@ type Network struct {
 	Description  string `json:"description"`
 	V4Subnet     string `json:"v4_subnet"`
 	V4SubnetMask int    `json:"v4_subnet_mask"`
+	V6Subnet     string `json:"v6_subnet"`
+	V6SubnetMask int    `json:"v6_subnet_mask"`
 	DateCreated  string `json:"date_created"`
 }
 
-// Create a new private network. A private network can only be used at the location for which it was created.
-func (n *NetworkServiceHandler) Create(ctx context.Context, regionID, description, cidrBlock string) (*Network, error) {
+// Create a new private network. A private network can only be used at the location for which it
+// was created. cidrBlock may be an IPv4 or IPv6 CIDR block; the address family is detected
+// automatically and populates the matching v4/v6 subnet fields.
+func (n *NetworkServiceHandler) Create(ctx context.Context, regionID, description, cidrBlock string) (*Network, *http.Response, error) {
 
 	uri := "/v1/network/create"
 
@@ -44,13 +53,18 @@ func (n *NetworkServiceHandler) Create(ctx context.Context, regionID, descriptio
 	if cidrBlock != "" {
 		_, ipNet, err := net.ParseCIDR(cidrBlock)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+
+		mask, _ := ipNet.Mask.Size()
+
 		if v4Subnet := ipNet.IP.To4(); v4Subnet != nil {
 			values.Add("v4_subnet", v4Subnet.String())
+			values.Add("v4_subnet_mask", strconv.Itoa(mask))
+		} else {
+			values.Add("v6_subnet", ipNet.IP.String())
+			values.Add("v6_subnet_mask", strconv.Itoa(mask))
 		}
-		mask, _ := ipNet.Mask.Size()
-		values.Add("v4_subnet_mask", strconv.Itoa(mask))
 	}
 
 	if description != "" {
@@ -60,21 +74,22 @@ func (n *NetworkServiceHandler) Create(ctx context.Context, regionID, descriptio
 	req, err := n.client.NewRequest(ctx, http.MethodPost, uri, values)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	network := new(Network)
-	err = n.client.DoWithContext(ctx, req, network)
+	resp, err := n.client.DoWithContext(ctx, req, network)
 
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return network, nil
+	return network, resp, nil
 }
 
-// Destroy (delete) a private network. Before destroying, a network must be disabled from all instances. See https://www.vultr.com/api/#server_private_network_disable
-func (n *NetworkServiceHandler) Destroy(ctx context.Context, networkID string) error {
+// Destroy (delete) a private network. Before destroying, the network must be detached from every
+// instance it is attached to, via DetachInstance.
+func (n *NetworkServiceHandler) Destroy(ctx context.Context, networkID string) (*http.Response, error) {
 	uri := "/v1/network/destroy"
 
 	values := url.Values{
@@ -84,31 +99,33 @@ func (n *NetworkServiceHandler) Destroy(ctx context.Context, networkID string) e
 	req, err := n.client.NewRequest(ctx, http.MethodPost, uri, values)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = n.client.DoWithContext(ctx, req, nil)
+	resp, err := n.client.DoWithContext(ctx, req, nil)
 
 	if err != nil {
-		return err
+		return resp, err
 	}
 
-	return nil
+	return resp, nil
 }
 
-// GetList lists all private networks on the current account
-func (n *NetworkServiceHandler) GetList(ctx context.Context) ([]Network, error) {
+// GetList lists private networks on the current account, optionally filtered and paged by
+// options. /v1/network/list has no native filtering or pagination of its own and always returns
+// every network in one response, so both are applied client-side over that full result set.
+func (n *NetworkServiceHandler) GetList(ctx context.Context, options *ListOptions) ([]Network, *Meta, *http.Response, error) {
 	uri := "/v1/network/list"
 
 	req, err := n.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	var networkMap map[string]Network
-	err = n.client.DoWithContext(ctx, req, &networkMap)
+	resp, err := n.client.DoWithContext(ctx, req, &networkMap)
 	if err != nil {
-		return nil, err
+		return nil, nil, resp, err
 	}
 
 	var networks []Network
@@ -116,5 +133,146 @@ func (n *NetworkServiceHandler) GetList(ctx context.Context) ([]Network, error)
 		networks = append(networks, network)
 	}
 
-	return networks, nil
+	// Map iteration order is random; sort so paging is stable across calls.
+	sort.Slice(networks, func(i, j int) bool { return networks[i].NetworkID < networks[j].NetworkID })
+
+	if regionID := options.regionID(); regionID != "" {
+		filtered := networks[:0]
+		for _, network := range networks {
+			if network.RegionID == regionID {
+				filtered = append(filtered, network)
+			}
+		}
+		networks = filtered
+	}
+
+	total := len(networks)
+	page, perPage := options.paging()
+
+	start := (page - 1) * perPage
+	if start > total {
+		start = total
+	}
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	meta := &Meta{Total: total}
+	if end < total {
+		meta.Links = &Links{Next: strconv.Itoa(page + 1)}
+	}
+	if start > 0 {
+		if meta.Links == nil {
+			meta.Links = &Links{}
+		}
+		meta.Links.Prev = strconv.Itoa(page - 1)
+	}
+
+	return networks[start:end], meta, resp, nil
+}
+
+// AttachInstance enables networkID on instanceID, letting the instance send and receive traffic
+// on the private network. See https://www.vultr.com/api/#server_private_network_enable
+func (n *NetworkServiceHandler) AttachInstance(ctx context.Context, networkID, instanceID string) (*http.Response, error) {
+	uri := "/v1/server/private_network_enable"
+
+	values := url.Values{
+		"SUBID":     {instanceID},
+		"NETWORKID": {networkID},
+	}
+
+	req, err := n.client.NewRequest(ctx, http.MethodPost, uri, values)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := n.client.DoWithContext(ctx, req, nil)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// DetachInstance disables networkID on instanceID. A network must be detached from every
+// instance it is attached to before it can be destroyed.
+// See https://www.vultr.com/api/#server_private_network_disable
+func (n *NetworkServiceHandler) DetachInstance(ctx context.Context, networkID, instanceID string) (*http.Response, error) {
+	uri := "/v1/server/private_network_disable"
+
+	values := url.Values{
+		"SUBID":     {instanceID},
+		"NETWORKID": {networkID},
+	}
+
+	req, err := n.client.NewRequest(ctx, http.MethodPost, uri, values)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := n.client.DoWithContext(ctx, req, nil)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// ListInstances returns the IDs of all instances that currently have networkID enabled.
+func (n *NetworkServiceHandler) ListInstances(ctx context.Context, networkID string) ([]string, *http.Response, error) {
+	uri := "/v1/network/list_instances"
+
+	values := url.Values{
+		"NETWORKID": {networkID},
+	}
+
+	req, err := n.client.NewRequest(ctx, http.MethodGet, uri, values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var instanceMap map[string]struct {
+		SUBID string `json:"SUBID"`
+	}
+	resp, err := n.client.DoWithContext(ctx, req, &instanceMap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var instances []string
+	for _, instance := range instanceMap {
+		instances = append(instances, instance.SUBID)
+	}
+
+	return instances, resp, nil
+}
+
+// Update renames (redescribes) an existing private network. Previously the only way to rename a
+// network was to destroy and recreate it, forcing a detach from every attached instance.
+func (n *NetworkServiceHandler) Update(ctx context.Context, networkID, description string) (*http.Response, error) {
+	uri := "/v1/network/label_set"
+
+	values := url.Values{
+		"NETWORKID":   {networkID},
+		"description": {description},
+	}
+
+	req, err := n.client.NewRequest(ctx, http.MethodPost, uri, values)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := n.client.DoWithContext(ctx, req, nil)
+
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
 }
@@ -0,0 +1,57 @@
+package govultr
+
+import "strconv"
+
+const defaultPerPage = 25
+
+// ListOptions controls client-side pagination and filtering of list endpoints that, like the
+// legacy v1 network list, always return their full result set in one response.
+type ListOptions struct {
+	// Page is the page of results to return, starting at 1. Ignored if Cursor is set.
+	Page int
+
+	// PerPage is the number of results to return per page. Defaults to 25.
+	PerPage int
+
+	// Cursor pages through results by an opaque cursor (as returned in Meta.Links) rather than a
+	// page number.
+	Cursor string
+
+	// RegionID, if set, restricts results to the given region.
+	RegionID string
+}
+
+// paging resolves the effective page number and page size for options, defaulting to page 1
+// and a page size of defaultPerPage when unset. Cursor, when present, takes precedence over Page.
+func (l *ListOptions) paging() (page, perPage int) {
+	page, perPage = 1, defaultPerPage
+
+	if l == nil {
+		return page, perPage
+	}
+
+	if l.PerPage > 0 {
+		perPage = l.PerPage
+	}
+
+	if l.Cursor != "" {
+		if c, err := strconv.Atoi(l.Cursor); err == nil && c > 0 {
+			page = c
+		}
+		return page, perPage
+	}
+
+	if l.Page > 0 {
+		page = l.Page
+	}
+
+	return page, perPage
+}
+
+// regionID returns the region to filter by, or "" if options is nil or unset.
+func (l *ListOptions) regionID() string {
+	if l == nil {
+		return ""
+	}
+	return l.RegionID
+}
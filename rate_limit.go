@@ -0,0 +1,111 @@
+package govultr
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a minimal token-bucket rate limiter used to cap outgoing API requests to a
+// configured rate, allowing short bursts above it.
+type tokenBucket struct {
+	mu sync.Mutex
+
+	rate  float64
+	burst float64
+
+	tokens   float64
+	lastFill time.Time
+}
+
+func newTokenBucket(rps float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:     rps,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastFill: time.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is done.
+func (t *tokenBucket) wait(ctx context.Context) error {
+	for {
+		t.mu.Lock()
+
+		now := time.Now()
+		t.tokens += now.Sub(t.lastFill).Seconds() * t.rate
+		if t.tokens > t.burst {
+			t.tokens = t.burst
+		}
+		t.lastFill = now
+
+		if t.tokens >= 1 {
+			t.tokens--
+			t.mu.Unlock()
+			return nil
+		}
+
+		sleep := time.Duration((1 - t.tokens) / t.rate * float64(time.Second))
+		t.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sleep):
+		}
+	}
+}
+
+// retryPolicy controls how DoWithContext retries failed requests.
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+// shouldRetry reports whether a request should be retried. A nil resp means the request never
+// got a response at all (a transport-level failure) and is always retried; a non-nil resp means
+// the server was reached, and retryability is decided from its status code alone, ignoring err
+// (do wraps a non-2xx status in a non-nil error via CheckResponse, but a 4xx like 400/401/403/404
+// is a client mistake, not something a retry will fix).
+func (r *retryPolicy) shouldRetry(resp *http.Response, err error) bool {
+	if resp == nil {
+		return err != nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// maxRetryBackoff caps the exponential backoff computed by delay, regardless of baseDelay or
+// attempt count.
+const maxRetryBackoff = 60 * time.Second
+
+// maxBackoffShift caps the exponent used in 1<<attempt so it can never overflow int64, however
+// large maxAttempts is configured.
+const maxBackoffShift = 30
+
+// delay returns how long to wait before the next attempt, honoring a numeric Retry-After header
+// when present and otherwise backing off exponentially from baseDelay with jitter, capped at
+// maxRetryBackoff.
+func (r *retryPolicy) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if ra := resp.Header.Get("Retry-After"); ra != "" {
+			if secs, err := strconv.Atoi(ra); err == nil {
+				return time.Duration(secs) * time.Second
+			}
+		}
+	}
+
+	shift := attempt
+	if shift > maxBackoffShift {
+		shift = maxBackoffShift
+	}
+
+	backoff := r.baseDelay * time.Duration(1<<uint(shift))
+	if backoff <= 0 || backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff)/2+1))
+}
@@ -0,0 +1,80 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	policy := &retryPolicy{maxAttempts: 3, baseDelay: time.Millisecond}
+	transportErr := errors.New("connection reset")
+
+	cases := []struct {
+		name string
+		resp *http.Response
+		err  error
+		want bool
+	}{
+		{"transport error", nil, transportErr, true},
+		{"200 ok", &http.Response{StatusCode: http.StatusOK}, nil, false},
+		{"400 bad request", &http.Response{StatusCode: http.StatusBadRequest}, errors.New("vultr: 400"), false},
+		{"401 unauthorized", &http.Response{StatusCode: http.StatusUnauthorized}, errors.New("vultr: 401"), false},
+		{"403 forbidden", &http.Response{StatusCode: http.StatusForbidden}, errors.New("vultr: 403"), false},
+		{"404 not found", &http.Response{StatusCode: http.StatusNotFound}, errors.New("vultr: 404"), false},
+		{"429 too many requests", &http.Response{StatusCode: http.StatusTooManyRequests}, errors.New("vultr: 429"), true},
+		{"500 internal server error", &http.Response{StatusCode: http.StatusInternalServerError}, errors.New("vultr: 500"), true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := policy.shouldRetry(tc.resp, tc.err); got != tc.want {
+				t.Errorf("shouldRetry(%v, %v) = %v, want %v", tc.resp, tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelayHighAttemptCountDoesNotPanic(t *testing.T) {
+	policy := &retryPolicy{maxAttempts: 50, baseDelay: time.Second}
+
+	for attempt := 0; attempt < policy.maxAttempts; attempt++ {
+		d := policy.delay(attempt, nil)
+		if d <= 0 || d > maxRetryBackoff {
+			t.Errorf("delay(%d, nil) = %v, want a value in (0, %v]", attempt, d, maxRetryBackoff)
+		}
+	}
+}
+
+func TestTokenBucketWaitThrottles(t *testing.T) {
+	tb := newTokenBucket(10, 1) // 10 rps, burst of 1
+	ctx := context.Background()
+
+	if err := tb.wait(ctx); err != nil {
+		t.Fatalf("first wait() error = %v", err)
+	}
+
+	start := time.Now()
+	if err := tb.wait(ctx); err != nil {
+		t.Fatalf("second wait() error = %v", err)
+	}
+	elapsed := time.Since(start)
+
+	if elapsed < 90*time.Millisecond {
+		t.Errorf("second wait() returned after %v, want it to block for ~100ms with no tokens left", elapsed)
+	}
+}
+
+func TestTokenBucketWaitRespectsContextCancellation(t *testing.T) {
+	tb := newTokenBucket(1, 1)
+	tb.tokens = 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := tb.wait(ctx); err == nil {
+		t.Fatal("wait() error = nil, want context cancellation error")
+	}
+}